@@ -0,0 +1,173 @@
+// Package describe renders kubectl-describe-style breakdowns of ramen
+// resources. Each resource kind gets a small set of typed formatters that
+// return a Section, so the same infrastructure can later describe VRGs,
+// DRPolicies, and DRClusters alongside DRPlacementControls.
+package describe
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	ocmworkv1 "github.com/open-cluster-management/api/work/v1"
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Section is a named, pre-rendered block of describe output, mirroring how
+// kubectl describe groups fields (Metadata, Spec, Status, ...).
+type Section struct {
+	Title string
+	Lines []string
+}
+
+// DRPC renders a kubectl-describe-style breakdown of the named
+// DRPlacementControl: metadata, spec, status (with condition ages), the
+// per-cluster ProtectedPVCs table, and the associated ManifestWorks pulled
+// from each managed cluster.
+func DRPC(ctx context.Context, hubClient client.Reader, managedClients map[string]client.Reader, namespace, name string) (*ramen.DRPlacementControl, []Section, error) {
+	drpc := &ramen.DRPlacementControl{}
+	if err := hubClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, drpc); err != nil {
+		return nil, nil, fmt.Errorf("failed to get DRPC %s/%s: %w", namespace, name, err)
+	}
+
+	sections := []Section{
+		metadataSection(drpc),
+		specSection(drpc),
+		statusSection(drpc),
+		protectedPVCsSection(ctx, managedClients, namespace, name),
+		manifestWorksSection(ctx, managedClients, name),
+	}
+
+	return drpc, sections, nil
+}
+
+func metadataSection(drpc *ramen.DRPlacementControl) Section {
+	return Section{
+		Title: "Metadata",
+		Lines: []string{
+			fmt.Sprintf("Name:      %s", drpc.Name),
+			fmt.Sprintf("Namespace: %s", drpc.Namespace),
+			fmt.Sprintf("Created:   %s", drpc.CreationTimestamp.Format(time.RFC3339)),
+		},
+	}
+}
+
+func specSection(drpc *ramen.DRPlacementControl) Section {
+	spec := drpc.Spec
+	lines := []string{
+		fmt.Sprintf("Action:           %s", spec.Action),
+		fmt.Sprintf("DRPolicy:         %s", spec.DRPolicyRef.Name),
+		fmt.Sprintf("PlacementRef:     %s/%s", spec.PlacementRef.Kind, spec.PlacementRef.Name),
+		fmt.Sprintf("PreferredCluster: %s", spec.PreferredCluster),
+		fmt.Sprintf("FailoverCluster:  %s", spec.FailoverCluster),
+	}
+
+	if spec.PVCSelector.LabelSelector != nil {
+		lines = append(lines, fmt.Sprintf("PVCSelector:      %s", spec.PVCSelector.LabelSelector.String()))
+	}
+
+	return Section{Title: "Spec", Lines: lines}
+}
+
+func statusSection(drpc *ramen.DRPlacementControl) Section {
+	lines := []string{
+		fmt.Sprintf("Phase:       %s", drpc.Status.Phase),
+		fmt.Sprintf("Progression: %s", drpc.Status.Progression),
+	}
+
+	if drpc.Status.LastGroupSyncTime != nil {
+		lines = append(lines, fmt.Sprintf("LastGroupSyncTime: %s", drpc.Status.LastGroupSyncTime.Format(time.RFC3339)))
+	}
+
+	lines = append(lines, "Conditions:")
+	for _, cond := range drpc.Status.Conditions {
+		lines = append(lines, fmt.Sprintf("  %-24s %-8s %-6s %s",
+			cond.Type, cond.Status, formatAge(cond.LastTransitionTime.Time), cond.Message))
+	}
+
+	return Section{Title: "Status", Lines: lines}
+}
+
+// formatAge renders a duration the way kubectl describe does: the
+// coarsest unit that still fits (seconds, minutes, or hours).
+func formatAge(t time.Time) string {
+	if t.IsZero() {
+		return "unknown"
+	}
+
+	d := time.Since(t)
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	default:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+}
+
+// protectedPVCsSection queries the VolumeReplicationGroup matching this DRPC
+// on every managed cluster (ramen names the VRG after the DRPC) and tables
+// the PVCs it is protecting.
+func protectedPVCsSection(ctx context.Context, managedClients map[string]client.Reader, namespace, name string) Section {
+	lines := []string{fmt.Sprintf("%-10s %-30s %-20s", "CLUSTER", "PVC", "REPLICATIONSTATE")}
+
+	for _, clusterName := range sortedKeys(managedClients) {
+		vrg := &ramen.VolumeReplicationGroup{}
+		if err := managedClients[clusterName].Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, vrg); err != nil {
+			continue
+		}
+
+		for _, pvc := range vrg.Status.ProtectedPVCs {
+			lines = append(lines, fmt.Sprintf("%-10s %-30s %-20s", clusterName, pvc.Name, pvc.ReplicationState))
+		}
+	}
+
+	return Section{Title: "ProtectedPVCs", Lines: lines}
+}
+
+// manifestWorksSection lists the ManifestWorks on each managed cluster
+// whose name references this DRPC.
+func manifestWorksSection(ctx context.Context, managedClients map[string]client.Reader, name string) Section {
+	lines := []string{fmt.Sprintf("%-10s %-40s %-10s", "CLUSTER", "NAME", "APPLIED")}
+
+	for _, clusterName := range sortedKeys(managedClients) {
+		mwList := &ocmworkv1.ManifestWorkList{}
+		if err := managedClients[clusterName].List(ctx, mwList, &client.ListOptions{}); err != nil {
+			continue
+		}
+
+		for _, mw := range mwList.Items {
+			if !strings.Contains(mw.Name, name) {
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("%-10s %-40s %-10s", clusterName, mw.Name, manifestWorkAppliedStatus(mw)))
+		}
+	}
+
+	return Section{Title: "ManifestWorks", Lines: lines}
+}
+
+func manifestWorkAppliedStatus(mw ocmworkv1.ManifestWork) string {
+	for _, cond := range mw.Status.Conditions {
+		if cond.Type == "Applied" {
+			return string(cond.Status)
+		}
+	}
+
+	return "Unknown"
+}
+
+func sortedKeys(m map[string]client.Reader) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
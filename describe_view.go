@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/raghavendra-talur/ramenbooth/describe"
+	"github.com/raghavendra-talur/ramenbooth/watcher"
+)
+
+// describeState holds a full-screen kubectl-describe-style view of one
+// DRPC, refreshed live from the hub/managed cluster informer cache.
+type describeState struct {
+	drpc     drpcInfo
+	object   *ramen.DRPlacementControl
+	sections []describe.Section
+	viewport viewport.Model
+}
+
+// describeResultMsg delivers a freshly rendered describe view, or an error
+// to surface on the status line.
+type describeResultMsg struct {
+	drpc     drpcInfo
+	object   *ramen.DRPlacementControl
+	sections []describe.Section
+	err      error
+}
+
+// managedClients builds a name -> reader map for every non-hub cluster
+// whose watcher has finished its initial sync, reading from the same
+// informer cache the watcher subsystem keeps up to date instead of
+// opening a new direct connection per call.
+func managedClients(registry *watcher.Registry, clusters []clusterInfo) map[string]client.Reader {
+	clients := map[string]client.Reader{}
+
+	for _, c := range clusters {
+		if c.hub {
+			continue
+		}
+		if reader, ok := registry.Get(c.name); ok {
+			clients[c.name] = reader
+		}
+	}
+
+	return clients
+}
+
+func describeDRPCCmd(registry *watcher.Registry, clusters []clusterInfo, d drpcInfo) tea.Cmd {
+	return func() tea.Msg {
+		hubReader, ok := registry.Get(clusters[0].name)
+		if !ok {
+			return describeResultMsg{drpc: d, err: fmt.Errorf("hub watcher cache not ready yet")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		object, sections, err := describe.DRPC(ctx, hubReader, managedClients(registry, clusters), d.namespace, d.name)
+		if err != nil {
+			return describeResultMsg{drpc: d, err: err}
+		}
+
+		return describeResultMsg{drpc: d, object: object, sections: sections}
+	}
+}
+
+// renderSections flattens describe.Section values into the plain text a
+// viewport can display.
+func renderSections(sections []describe.Section) string {
+	var b strings.Builder
+	for _, s := range sections {
+		fmt.Fprintf(&b, "%s:\n", s.Title)
+		for _, line := range s.Lines {
+			fmt.Fprintf(&b, "  %s\n", line)
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+func newDescribeState(width, height int, msg describeResultMsg) *describeState {
+	vp := viewport.New(width, height)
+	vp.SetContent(renderSections(msg.sections))
+
+	return &describeState{
+		drpc:     msg.drpc,
+		object:   msg.object,
+		sections: msg.sections,
+		viewport: vp,
+	}
+}
+
+// yankYAML marshals the described DRPC to YAML and copies it to the
+// clipboard, returning a short status line message.
+func yankYAML(object *ramen.DRPlacementControl) string {
+	b, err := yaml.Marshal(object)
+	if err != nil {
+		return fmt.Sprintf("failed to marshal DRPC YAML: %v", err)
+	}
+
+	if err := clipboard.WriteAll(string(b)); err != nil {
+		return fmt.Sprintf("failed to copy YAML to clipboard: %v", err)
+	}
+
+	return "copied DRPC YAML to clipboard"
+}
+
+func getDescribeViewStyle(d describeState, width, height int) string {
+	header := fmt.Sprintf("Describe %s/%s (esc=close, y=yank YAML, pgup/pgdn=scroll)\n\n",
+		d.drpc.namespace, d.drpc.name)
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.ThickBorder(), true).
+		Width(width).
+		Height(height).
+		Align(lipgloss.Left)
+
+	return style.Render(header + d.viewport.View())
+}
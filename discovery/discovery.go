@@ -0,0 +1,125 @@
+// Package discovery builds the set of managed clusters ramenbooth should
+// display by reading DRPolicy and DRCluster objects off the hub, instead of
+// requiring one CLI flag per managed cluster.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// clustersFileName is the mapping of cluster name to kubeconfig path,
+// looked for under kubeconfigDir, or under ~/.ramenbooth when kubeconfigDir
+// is empty.
+const clustersFileName = "clusters.yaml"
+
+// Cluster is a managed cluster discovered from the hub's DRClusters, paired
+// with the kubeconfig ramenbooth should use to connect to it.
+type Cluster struct {
+	Name       string
+	Kubeconfig string
+}
+
+// Discover lists DRPolicy and DRCluster objects on the hub and returns every
+// managed cluster referenced by a DRPolicy, resolving each one's kubeconfig
+// from kubeconfigDir/<name>, falling back to the name -> path mapping in
+// clusters.yaml (kubeconfigDir/clusters.yaml, or ~/.ramenbooth/clusters.yaml
+// if kubeconfigDir is empty).
+func Discover(ctx context.Context, hubClient client.Client, kubeconfigDir string) ([]Cluster, error) {
+	policies := &ramen.DRPolicyList{}
+	if err := hubClient.List(ctx, policies, &client.ListOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list DRPolicies: %w", err)
+	}
+
+	drClusters := &ramen.DRClusterList{}
+	if err := hubClient.List(ctx, drClusters, &client.ListOptions{}); err != nil {
+		return nil, fmt.Errorf("failed to list DRClusters: %w", err)
+	}
+
+	mapping, err := loadClusterMapping(kubeconfigDir)
+	if err != nil {
+		return nil, err
+	}
+
+	referenced := map[string]bool{}
+	for _, p := range policies.Items {
+		for _, name := range p.Spec.DRClusters {
+			referenced[name] = true
+		}
+	}
+
+	var clusters []Cluster
+	for _, dc := range drClusters.Items {
+		if !referenced[dc.Name] {
+			continue
+		}
+
+		kubeconfig, err := resolveKubeconfig(dc.Name, kubeconfigDir, mapping)
+		if err != nil {
+			return nil, fmt.Errorf("failed to discover cluster %s: %w", dc.Name, err)
+		}
+
+		clusters = append(clusters, Cluster{Name: dc.Name, Kubeconfig: kubeconfig})
+	}
+
+	return clusters, nil
+}
+
+// resolveKubeconfig looks for kubeconfigDir/<name> first, falling back to
+// the name -> path mapping from clusters.yaml, matching Discover's doc
+// comment. It errors rather than returning "" when neither resolves:
+// fetchClusterClient("") silently falls back to the ambient kubeconfig,
+// which for a tool that issues Failover/Relocate mutations would mean
+// attaching a mislabeled cluster box to the wrong cluster instead of
+// failing loudly.
+func resolveKubeconfig(name, kubeconfigDir string, mapping map[string]string) (string, error) {
+	if kubeconfigDir != "" {
+		path := filepath.Join(kubeconfigDir, name)
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		}
+	}
+
+	if path, ok := mapping[name]; ok {
+		return path, nil
+	}
+
+	return "", fmt.Errorf("no kubeconfig found for cluster %s under %q or in clusters.yaml", name, kubeconfigDir)
+}
+
+// loadClusterMapping reads the optional cluster-name -> kubeconfig-path
+// mapping from kubeconfigDir/clusters.yaml, or from
+// ~/.ramenbooth/clusters.yaml when kubeconfigDir is empty; the home file is
+// not consulted when kubeconfigDir is set. A missing file is not an error: callers then
+// rely on kubeconfigDir/<name> instead.
+func loadClusterMapping(kubeconfigDir string) (map[string]string, error) {
+	path := filepath.Join(kubeconfigDir, clustersFileName)
+	if kubeconfigDir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return map[string]string{}, nil
+		}
+		path = filepath.Join(home, ".ramenbooth", clustersFileName)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]string{}, nil
+		}
+		return nil, fmt.Errorf("failed to read cluster mapping %s: %w", path, err)
+	}
+
+	mapping := map[string]string{}
+	if err := yaml.Unmarshal(data, &mapping); err != nil {
+		return nil, fmt.Errorf("failed to parse cluster mapping %s: %w", path, err)
+	}
+
+	return mapping, nil
+}
@@ -0,0 +1,68 @@
+package discovery
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestResolveKubeconfig_FromKubeconfigDir(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "east")
+	if err := os.WriteFile(path, []byte("kubeconfig"), 0o600); err != nil {
+		t.Fatalf("failed to write fixture kubeconfig: %v", err)
+	}
+
+	got, err := resolveKubeconfig("east", dir, map[string]string{})
+	if err != nil {
+		t.Fatalf("resolveKubeconfig() = %v, want nil", err)
+	}
+	if got != path {
+		t.Fatalf("resolveKubeconfig() = %q, want %q", got, path)
+	}
+}
+
+func TestResolveKubeconfig_FromMapping(t *testing.T) {
+	dir := t.TempDir()
+	mapping := map[string]string{"west": "/elsewhere/west.kubeconfig"}
+
+	got, err := resolveKubeconfig("west", dir, mapping)
+	if err != nil {
+		t.Fatalf("resolveKubeconfig() = %v, want nil", err)
+	}
+	if got != mapping["west"] {
+		t.Fatalf("resolveKubeconfig() = %q, want %q", got, mapping["west"])
+	}
+}
+
+func TestResolveKubeconfig_NotFound(t *testing.T) {
+	if _, err := resolveKubeconfig("missing", t.TempDir(), map[string]string{}); err == nil {
+		t.Fatal("resolveKubeconfig() = nil, want error when neither kubeconfigDir nor clusters.yaml resolves the name")
+	}
+}
+
+func TestLoadClusterMapping_MissingFileIsNotAnError(t *testing.T) {
+	mapping, err := loadClusterMapping(t.TempDir())
+	if err != nil {
+		t.Fatalf("loadClusterMapping() = %v, want nil for a missing clusters.yaml", err)
+	}
+	if len(mapping) != 0 {
+		t.Fatalf("loadClusterMapping() = %v, want empty mapping", mapping)
+	}
+}
+
+func TestLoadClusterMapping_ParsesYAML(t *testing.T) {
+	dir := t.TempDir()
+	data := "east: /kubeconfigs/east\nwest: /kubeconfigs/west\n"
+	if err := os.WriteFile(filepath.Join(dir, clustersFileName), []byte(data), 0o600); err != nil {
+		t.Fatalf("failed to write fixture clusters.yaml: %v", err)
+	}
+
+	mapping, err := loadClusterMapping(dir)
+	if err != nil {
+		t.Fatalf("loadClusterMapping() = %v, want nil", err)
+	}
+	if mapping["east"] != "/kubeconfigs/east" || mapping["west"] != "/kubeconfigs/west" {
+		t.Fatalf("loadClusterMapping() = %v, want east/west entries", mapping)
+	}
+}
@@ -0,0 +1,82 @@
+// Package dractions mutates the lifecycle of a DRPlacementControl on the hub
+// cluster and waits for the resulting phase transition, mirroring the
+// Deployed -> FailingOver -> FailedOver / Relocating -> Relocated flow used by
+// the ramen e2e test suite's own dractions package.
+package dractions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pollInterval is how often we re-Get the DRPC while waiting for its phase
+// to converge.
+const pollInterval = 2 * time.Second
+
+// Failover sets Spec.Action to Failover and Spec.FailoverCluster to
+// failoverCluster, then waits for Status.Phase to reach FailedOver.
+func Failover(ctx context.Context, c client.Client, namespace, name, failoverCluster string, timeout time.Duration) error {
+	drpc := &ramen.DRPlacementControl{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, drpc); err != nil {
+		return fmt.Errorf("failed to get DRPC %s/%s: %w", namespace, name, err)
+	}
+
+	drpc.Spec.Action = ramen.ActionFailover
+	drpc.Spec.FailoverCluster = failoverCluster
+
+	if err := c.Update(ctx, drpc); err != nil {
+		return fmt.Errorf("failed to failover DRPC %s/%s: %w", namespace, name, err)
+	}
+
+	return waitForPhase(ctx, c, namespace, name, ramen.FailedOver, timeout)
+}
+
+// Relocate sets Spec.Action to Relocate and Spec.PreferredCluster to
+// preferredCluster, then waits for Status.Phase to reach Relocated.
+func Relocate(ctx context.Context, c client.Client, namespace, name, preferredCluster string, timeout time.Duration) error {
+	drpc := &ramen.DRPlacementControl{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, drpc); err != nil {
+		return fmt.Errorf("failed to get DRPC %s/%s: %w", namespace, name, err)
+	}
+
+	drpc.Spec.Action = ramen.ActionRelocate
+	drpc.Spec.PreferredCluster = preferredCluster
+
+	if err := c.Update(ctx, drpc); err != nil {
+		return fmt.Errorf("failed to relocate DRPC %s/%s: %w", namespace, name, err)
+	}
+
+	return waitForPhase(ctx, c, namespace, name, ramen.Relocated, timeout)
+}
+
+// waitForPhase polls the DRPC until Status.Phase reaches want or timeout
+// elapses.
+func waitForPhase(ctx context.Context, c client.Client, namespace, name string, want ramen.DRState, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	drpc := &ramen.DRPlacementControl{}
+
+	for {
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, drpc); err != nil {
+			return fmt.Errorf("failed to get DRPC %s/%s: %w", namespace, name, err)
+		}
+
+		if drpc.Status.Phase == want {
+			return nil
+		}
+
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for DRPC %s/%s to reach phase %s, currently %s",
+				namespace, name, want, drpc.Status.Phase)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
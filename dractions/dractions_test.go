@@ -0,0 +1,89 @@
+package dractions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	if err := ramen.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add ramen to scheme: %v", err)
+	}
+
+	return scheme
+}
+
+// Failover and Relocate only mutate Spec; a fake client preserves whatever
+// Status the DRPC already had across Update, so seeding the post-transition
+// phase up front simulates a controller that has already converged by the
+// time waitForPhase's first poll runs.
+func TestFailover(t *testing.T) {
+	drpc := &ramen.DRPlacementControl{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "app"},
+		Status:     ramen.DRPlacementControlStatus{Phase: ramen.FailedOver},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(drpc).Build()
+
+	if err := Failover(context.Background(), c, "ns", "app", "west", time.Second); err != nil {
+		t.Fatalf("Failover() = %v, want nil", err)
+	}
+
+	got := &ramen.DRPlacementControl{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "app"}, got); err != nil {
+		t.Fatalf("Get() after Failover: %v", err)
+	}
+	if got.Spec.Action != ramen.ActionFailover || got.Spec.FailoverCluster != "west" {
+		t.Fatalf("Spec = %+v, want Action=%s FailoverCluster=west", got.Spec, ramen.ActionFailover)
+	}
+}
+
+func TestFailover_DRPCNotFound(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).Build()
+
+	if err := Failover(context.Background(), c, "ns", "missing", "west", time.Second); err == nil {
+		t.Fatal("Failover() = nil, want error for a DRPC that doesn't exist")
+	}
+}
+
+func TestRelocate(t *testing.T) {
+	drpc := &ramen.DRPlacementControl{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "app"},
+		Status:     ramen.DRPlacementControlStatus{Phase: ramen.Relocated},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(drpc).Build()
+
+	if err := Relocate(context.Background(), c, "ns", "app", "east", time.Second); err != nil {
+		t.Fatalf("Relocate() = %v, want nil", err)
+	}
+
+	got := &ramen.DRPlacementControl{}
+	if err := c.Get(context.Background(), client.ObjectKey{Namespace: "ns", Name: "app"}, got); err != nil {
+		t.Fatalf("Get() after Relocate: %v", err)
+	}
+	if got.Spec.Action != ramen.ActionRelocate || got.Spec.PreferredCluster != "east" {
+		t.Fatalf("Spec = %+v, want Action=%s PreferredCluster=east", got.Spec, ramen.ActionRelocate)
+	}
+}
+
+func TestWaitForPhase_Timeout(t *testing.T) {
+	drpc := &ramen.DRPlacementControl{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "app"},
+		Status:     ramen.DRPlacementControlStatus{Phase: ramen.DRState("FailingOver")},
+	}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme(t)).WithObjects(drpc).Build()
+
+	err := waitForPhase(context.Background(), c, "ns", "app", ramen.FailedOver, 0)
+	if err == nil {
+		t.Fatal("waitForPhase() = nil, want a timeout error when the phase never converges")
+	}
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// logsTickInterval is how often a focused log pane's events/logs are
+// refreshed from the cluster.
+const logsTickInterval = 3 * time.Second
+
+// ramenNamespaces are the namespaces whose pod logs a log pane tails.
+var ramenNamespaces = []string{"ramen-system", "ramen-ops"}
+
+// logView selects which feed a cluster's log pane is currently showing.
+type logView int
+
+const (
+	viewEvents logView = iota
+	viewLogs
+)
+
+// logPane holds the scrollable viewport and raw content for one cluster's
+// combined events/logs panel.
+type logPane struct {
+	viewport viewport.Model
+	view     logView
+	events   string
+	logs     string
+}
+
+func newLogPane(width, height int) logPane {
+	return logPane{viewport: viewport.New(width, height), view: viewEvents}
+}
+
+// wrapLogs splits each line of content into len(line)/maxWidth segments
+// plus any leftover, joined with "\n", because operator log lines and
+// event messages frequently exceed the panel width.
+func wrapLogs(content string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return content
+	}
+
+	var wrapped []string
+	for _, line := range strings.Split(content, "\n") {
+		if len(line) == 0 {
+			wrapped = append(wrapped, line)
+			continue
+		}
+
+		for start := 0; start < len(line); start += maxWidth {
+			end := start + maxWidth
+			if end > len(line) {
+				end = len(line)
+			}
+			wrapped = append(wrapped, line[start:end])
+		}
+	}
+
+	return strings.Join(wrapped, "\n")
+}
+
+// setContent re-wraps and installs content for the pane's active view.
+func (p *logPane) setContent() {
+	content := p.events
+	if p.view == viewLogs {
+		content = p.logs
+	}
+	p.viewport.SetContent(wrapLogs(content, p.viewport.Width))
+}
+
+// resize updates the pane's dimensions and re-wraps its content, meant to
+// be called on every tea.WindowSizeMsg.
+func (p *logPane) resize(width, height int) {
+	p.viewport.Width = width
+	p.viewport.Height = height
+	p.setContent()
+}
+
+func (p *logPane) toggleView() {
+	if p.view == viewEvents {
+		p.view = viewLogs
+	} else {
+		p.view = viewEvents
+	}
+	p.setContent()
+}
+
+// logsMsg delivers a freshly fetched batch of events and pod logs for one
+// cluster, keeping Update non-blocking.
+type logsMsg struct {
+	clusterIndex int
+	events       string
+	logs         string
+}
+
+func logsTickCmd(clusterIndex int) tea.Cmd {
+	return tea.Tick(logsTickInterval, func(t time.Time) tea.Msg {
+		return fetchLogsMsg{clusterIndex: clusterIndex}
+	})
+}
+
+// fetchLogsMsg triggers a refetch of events/logs for clusterIndex.
+type fetchLogsMsg struct {
+	clusterIndex int
+}
+
+func formatEvents(list *corev1.EventList) string {
+	var b strings.Builder
+	for _, e := range list.Items {
+		fmt.Fprintf(&b, "%s %s/%s: %s\n",
+			e.LastTimestamp.Format(time.RFC3339), e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Message)
+	}
+
+	return b.String()
+}
+
+// tailRamenLogs fetches the tail of every pod's logs in ramen-system and
+// ramen-ops on the cluster clientset points at.
+func tailRamenLogs(ctx context.Context, clientset *kubernetes.Clientset) string {
+	var b strings.Builder
+	tailLines := int64(50)
+
+	for _, ns := range ramenNamespaces {
+		pods, err := clientset.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			continue
+		}
+
+		for _, pod := range pods.Items {
+			req := clientset.CoreV1().Pods(ns).GetLogs(pod.Name, &corev1.PodLogOptions{TailLines: &tailLines})
+			stream, err := req.Stream(ctx)
+			if err != nil {
+				continue
+			}
+
+			scanner := bufio.NewScanner(stream)
+			for scanner.Scan() {
+				fmt.Fprintf(&b, "[%s/%s] %s\n", ns, pod.Name, scanner.Text())
+			}
+			stream.Close()
+		}
+	}
+
+	return b.String()
+}
+
+// fetchLogsCmd fetches events and pod logs for one cluster in the
+// background and delivers the result as a logsMsg, so Update never blocks
+// on client-go calls.
+func fetchLogsCmd(clusterIndex int, kubeconfig string) tea.Cmd {
+	return func() tea.Msg {
+		kclient := fetchClusterClient(kubeconfig)
+		if kclient == nil {
+			return logsMsg{clusterIndex: clusterIndex}
+		}
+
+		config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+		if err != nil {
+			return logsMsg{clusterIndex: clusterIndex}
+		}
+
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return logsMsg{clusterIndex: clusterIndex}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		events := &corev1.EventList{}
+		_ = kclient.List(ctx, events, &client.ListOptions{})
+
+		return logsMsg{
+			clusterIndex: clusterIndex,
+			events:       formatEvents(events),
+			logs:         tailRamenLogs(ctx, clientset),
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package main
+
+import "testing"
+
+func TestWrapLogs_WrapsLongLines(t *testing.T) {
+	got := wrapLogs("abcdefghij", 4)
+	want := "abcd\nefgh\nij"
+	if got != want {
+		t.Errorf("wrapLogs() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapLogs_PreservesShortLinesAndBlankLines(t *testing.T) {
+	got := wrapLogs("short\n\nline", 10)
+	want := "short\n\nline"
+	if got != want {
+		t.Errorf("wrapLogs() = %q, want %q", got, want)
+	}
+}
+
+func TestWrapLogs_NonPositiveMaxWidthReturnsContentUnchanged(t *testing.T) {
+	content := "whatever content\nspanning lines"
+	if got := wrapLogs(content, 0); got != content {
+		t.Errorf("wrapLogs() with maxWidth=0 = %q, want unchanged %q", got, content)
+	}
+}
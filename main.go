@@ -5,41 +5,61 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	ocmworkv1 "github.com/open-cluster-management/api/work/v1"
 	ramen "github.com/ramendr/ramen/api/v1alpha1"
 	"go.uber.org/zap/zapcore"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	clusterdiscovery "github.com/raghavendra-talur/ramenbooth/discovery"
+	"github.com/raghavendra-talur/ramenbooth/dractions"
+	"github.com/raghavendra-talur/ramenbooth/schemes"
+	"github.com/raghavendra-talur/ramenbooth/watcher"
 )
 
+// actionTimeout bounds how long we wait for a DRPC to reach the requested
+// phase before reporting a timeout on the status line.
+const actionTimeout = 5 * time.Minute
+
+// resyncInterval is the bounded safety-net interval at which we fully
+// re-list every cluster, in case a watch event was silently dropped.
+const resyncInterval = 30 * time.Second
+
 var (
-	hub string
-	dr1 string
-	dr2 string
+	hub           string
+	kubeconfigDir string
 )
 
 func init() {
 	flag.StringVar(&hub, "hub", "", "path to hub kubeconfig")
-	flag.StringVar(&dr1, "dr1", "", "path to dr1 kubeconfig")
-	flag.StringVar(&dr2, "dr2", "", "path to dr2 kubeconfig")
+	flag.StringVar(&kubeconfigDir, "kubeconfig-dir", "",
+		"directory of managed cluster kubeconfigs, named after each DRCluster "+
+			"(or containing a clusters.yaml mapping name to path); "+
+			"defaults to ~/.ramenbooth/clusters.yaml")
 	flag.Parse()
 }
 
+type drpcInfo struct {
+	name      string
+	namespace string
+}
+
 type clusterInfo struct {
 	name           string
 	status         string
 	context        string
 	namespaces     []string
-	DRPCs          []string
+	DRPCs          []drpcInfo
 	hub            bool
 	managedcluster bool
 }
@@ -54,23 +74,65 @@ func newClusterInfo(name, context string, hub, managedcluster bool) clusterInfo
 	}
 }
 
+// focusLevel tracks which cursor is active: the top-level cluster list, or
+// the DRPC list within the currently selected (hub) cluster.
+type focusLevel int
+
+const (
+	focusClusters focusLevel = iota
+	focusDRPCs
+)
+
+// confirmState holds a pending lifecycle action awaiting "y"/"n" confirmation.
+type confirmState struct {
+	action string // "Failover" or "Relocate"
+	drpc   drpcInfo
+	target string
+}
+
 type model struct {
 	clusters []clusterInfo
 	cursor   int
 	width    int
 	height   int
 	ticker   *time.Ticker
+
+	focus      focusLevel
+	drpcCursor int
+	confirm    *confirmState
+	statusMsg  string
+
+	logPanes []logPane
+	logFocus int // index into clusters/logPanes, or -1 if no panel is focused
+
+	describeView *describeState
+
+	// watchers hands out each cluster's synced informer cache, so the
+	// describe view can read from it instead of opening a new direct
+	// client per call.
+	watchers *watcher.Registry
+
+	// availability reports, per optional schemes.Provider, whether its CRDs
+	// are installed on the hub (e.g. "VolSync" -> false when VolSync isn't
+	// deployed). Surfaced today as the "Unavailable CRDs" status line;
+	// there are no VolSync/Velero/etc. panels in the TUI yet to hide.
+	availability map[string]bool
 }
 
-func initialModel() model {
+func initialModel(managed []clusterdiscovery.Cluster) model {
 	var m model
 	var clusters []clusterInfo
 
 	clusters = append(clusters, newClusterInfo("Hub", hub, true, false))
-	clusters = append(clusters, newClusterInfo("DR1", dr1, false, true))
-	clusters = append(clusters, newClusterInfo("DR2", dr2, false, true))
+	for _, c := range managed {
+		clusters = append(clusters, newClusterInfo(c.Name, c.Kubeconfig, false, true))
+	}
 
 	m.clusters = clusters
+	m.logFocus = -1
+	for range clusters {
+		m.logPanes = append(m.logPanes, newLogPane(0, 0))
+	}
 
 	return m
 }
@@ -80,7 +142,7 @@ func (m model) Init() tea.Cmd {
 }
 
 func tickCmd() tea.Cmd {
-	return tea.Tick(1*time.Second, func(t time.Time) tea.Msg {
+	return tea.Tick(resyncInterval, func(t time.Time) tea.Msg {
 		return t
 	})
 }
@@ -99,6 +161,23 @@ func fetchClusterClient(kubeconfig string) client.Client {
 	return kclient
 }
 
+// fetchDiscoveryClient builds a discovery client for the given kubeconfig,
+// used to probe which optional schemes.Provider CRDs are actually installed.
+// A nil return (bad kubeconfig) just means Availability reports nothing.
+func fetchDiscoveryClient(kubeconfig string) discovery.DiscoveryInterface {
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return nil
+	}
+
+	dclient, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return nil
+	}
+
+	return dclient
+}
+
 func updateClusterData(c *clusterInfo) {
 	kclient := fetchClusterClient(c.context)
 	if kclient == nil {
@@ -120,6 +199,58 @@ func updateClustersData(clusters *[]clusterInfo) tea.Cmd {
 	}
 }
 
+// updateClusterByNameCmd refreshes a single cluster's data in response to a
+// watcher.ChangeMsg, instead of re-listing every cluster on every change.
+func updateClusterByNameCmd(clusters *[]clusterInfo, name string) tea.Cmd {
+	return func() tea.Msg {
+		for i := range *clusters {
+			if (*clusters)[i].name == name {
+				updateClusterData(&(*clusters)[i])
+				break
+			}
+		}
+		return clusters
+	}
+}
+
+// actionResultMsg reports the outcome of a failover/relocate triggered from
+// the TUI, to be surfaced on the status line.
+type actionResultMsg struct {
+	action string
+	drpc   drpcInfo
+	err    error
+}
+
+func runFailoverCmd(d drpcInfo, target string) tea.Cmd {
+	return func() tea.Msg {
+		kclient := fetchClusterClient(hub)
+		if kclient == nil {
+			return actionResultMsg{action: "Failover", drpc: d, err: fmt.Errorf("no hub client available")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+		defer cancel()
+
+		err := dractions.Failover(ctx, kclient, d.namespace, d.name, target, actionTimeout)
+		return actionResultMsg{action: "Failover", drpc: d, err: err}
+	}
+}
+
+func runRelocateCmd(d drpcInfo, target string) tea.Cmd {
+	return func() tea.Msg {
+		kclient := fetchClusterClient(hub)
+		if kclient == nil {
+			return actionResultMsg{action: "Relocate", drpc: d, err: fmt.Errorf("no hub client available")}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), actionTimeout)
+		defer cancel()
+
+		err := dractions.Relocate(ctx, kclient, d.namespace, d.name, target, actionTimeout)
+		return actionResultMsg{action: "Relocate", drpc: d, err: err}
+	}
+}
+
 func filterRamenNamespaces(namespaces *corev1.NamespaceList) []string {
 	var filtered []string
 	for _, ns := range namespaces.Items {
@@ -149,23 +280,23 @@ func getRamenNamespaces(c *clusterInfo, kclient client.Client) []string {
 	return filterRamenNamespaces(ns)
 }
 
-func getDRPCs(c *clusterInfo, kclient client.Client) []string {
+func getDRPCs(c *clusterInfo, kclient client.Client) []drpcInfo {
 	if !c.hub {
-		return []string{}
+		return []drpcInfo{}
 	}
 
 	drpcs := &ramen.DRPlacementControlList{}
 	err := kclient.List(context.Background(), drpcs, &client.ListOptions{})
 	if err != nil {
-		return []string{}
+		return []drpcInfo{}
 	}
 
-	var drpcNames []string
+	var found []drpcInfo
 	for _, drpc := range drpcs.Items {
-		drpcNames = append(drpcNames, drpc.Name)
+		found = append(found, drpcInfo{name: drpc.Name, namespace: drpc.Namespace})
 	}
 
-	return drpcNames
+	return found
 }
 
 func getClusterStatus(c *clusterInfo, kclient client.Client) string {
@@ -178,29 +309,260 @@ func getClusterStatus(c *clusterInfo, kclient client.Client) string {
 	return "Healthy"
 }
 
+// hubCluster returns the hub entry, which is always clusters[0].
+func (m model) hubCluster() *clusterInfo {
+	return &m.clusters[0]
+}
+
+// focusedDRPC returns the DRPC at drpcCursor and true, or the zero value
+// and false if drpcCursor is out of range -- e.g. the hub's DRPC list
+// shrank (a deletion, a resync) while the user was focused on an index
+// that's no longer valid.
+func (m model) focusedDRPC() (drpcInfo, bool) {
+	drpcs := m.hubCluster().DRPCs
+	if m.drpcCursor < 0 || m.drpcCursor >= len(drpcs) {
+		return drpcInfo{}, false
+	}
+
+	return drpcs[m.drpcCursor], true
+}
+
+// hasManagedClusters reports whether discovery found at least one managed
+// cluster to target a lifecycle action at; m.clusters[0] is always the hub.
+func (m model) hasManagedClusters() bool {
+	return len(m.clusters) > 1
+}
+
+// failoverTarget and relocateTarget pick the managed cluster a lifecycle
+// action should move a DRPC to. With discovery now supporting more than two
+// managed clusters, failover targets the last discovered cluster and
+// relocate targets the first; picking a specific target out of a larger
+// MetroDR/regional-DR set is left to a future change. Both return "" when
+// discovery found no managed clusters; callers must check
+// hasManagedClusters before calling.
+func (m model) failoverTarget() string {
+	if !m.hasManagedClusters() {
+		return ""
+	}
+
+	return m.clusters[len(m.clusters)-1].name
+}
+
+func (m model) relocateTarget() string {
+	if !m.hasManagedClusters() {
+		return ""
+	}
+
+	return m.clusters[1].name
+}
+
+// logPaneSize returns the width and height each cluster's log pane should
+// be resized to given the current window dimensions.
+func logPaneSize(m model) (int, int) {
+	width := m.width/len(m.clusters) - 2
+	if width < 0 {
+		width = 0
+	}
+	return width, m.height / 4
+}
+
+// describeViewSize returns the width and height the full-screen describe
+// view should occupy.
+func describeViewSize(m model) (int, int) {
+	width := m.width - 4
+	height := m.height - 4
+	if width < 0 {
+		width = 0
+	}
+	if height < 0 {
+		height = 0
+	}
+	return width, height
+}
+
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case []clusterInfo:
 		m.clusters = msg
+		if max := len(m.hubCluster().DRPCs) - 1; m.drpcCursor > max {
+			m.drpcCursor = max
+		}
+		if m.drpcCursor < 0 {
+			m.drpcCursor = 0
+		}
 
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		w, h := logPaneSize(m)
+		for i := range m.logPanes {
+			m.logPanes[i].resize(w, h)
+		}
+		if m.describeView != nil {
+			dw, dh := describeViewSize(m)
+			m.describeView.viewport.Width = dw
+			m.describeView.viewport.Height = dh
+		}
+
+	case watcher.ChangeMsg:
+		cmds := []tea.Cmd{updateClusterByNameCmd(&m.clusters, msg.ClusterName)}
+		if m.describeView != nil {
+			cmds = append(cmds, describeDRPCCmd(m.watchers, m.clusters, m.describeView.drpc))
+		}
+		return m, tea.Batch(cmds...)
+
+	case watcher.ErrorMsg:
+		m.statusMsg = fmt.Sprintf("watcher for %s: %v", msg.ClusterName, msg.Err)
+
+	case fetchLogsMsg:
+		if msg.clusterIndex != m.logFocus {
+			// The pane lost focus since this tick was scheduled; stop
+			// the chain instead of fetching (and re-ticking) forever.
+			return m, nil
+		}
+		return m, tea.Batch(fetchLogsCmd(msg.clusterIndex, m.clusters[msg.clusterIndex].context), logsTickCmd(msg.clusterIndex))
+
+	case logsMsg:
+		p := &m.logPanes[msg.clusterIndex]
+		p.events = msg.events
+		p.logs = msg.logs
+		p.setContent()
+
+	case actionResultMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("%s %s failed: %v", msg.action, msg.drpc.name, msg.err)
+		} else {
+			m.statusMsg = fmt.Sprintf("%s %s succeeded", msg.action, msg.drpc.name)
+		}
+
+	case describeResultMsg:
+		if msg.err != nil {
+			m.statusMsg = fmt.Sprintf("describe %s failed: %v", msg.drpc.name, msg.err)
+		} else if m.describeView != nil && m.describeView.drpc == msg.drpc {
+			// A live refresh of the view already open: update its content
+			// in place so scroll position survives, instead of building a
+			// new viewport that would reset it to the top.
+			m.describeView.object = msg.object
+			m.describeView.sections = msg.sections
+			m.describeView.viewport.SetContent(renderSections(msg.sections))
+		} else {
+			w, h := describeViewSize(m)
+			m.describeView = newDescribeState(w, h, msg)
+		}
 
 	case tea.KeyMsg:
+		if m.describeView != nil {
+			switch msg.String() {
+			case "esc":
+				m.describeView = nil
+
+			case "y":
+				m.statusMsg = yankYAML(m.describeView.object)
+
+			case "pgup":
+				vp := &m.describeView.viewport
+				vp.LineUp(vp.Height)
+
+			case "pgdown":
+				vp := &m.describeView.viewport
+				vp.LineDown(vp.Height)
+			}
+			return m, nil
+		}
+
+		if m.confirm != nil {
+			switch msg.String() {
+			case "y", "enter":
+				c := *m.confirm
+				m.confirm = nil
+				if c.action == "Failover" {
+					return m, runFailoverCmd(c.drpc, c.target)
+				}
+				return m, runRelocateCmd(c.drpc, c.target)
+
+			case "n", "esc":
+				m.confirm = nil
+			}
+			return m, nil
+		}
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			return m, tea.Quit
 
 		case "up", "k":
-			if m.cursor > 0 {
+			if m.focus == focusDRPCs {
+				if m.drpcCursor > 0 {
+					m.drpcCursor--
+				}
+			} else if m.cursor > 0 {
 				m.cursor--
 			}
 
 		case "down", "j":
-			if m.cursor < len(m.clusters)-1 {
+			if m.focus == focusDRPCs {
+				if m.drpcCursor < len(m.hubCluster().DRPCs)-1 {
+					m.drpcCursor++
+				}
+			} else if m.cursor < len(m.clusters)-1 {
 				m.cursor++
 			}
+
+		case "enter":
+			if m.focus == focusClusters && m.clusters[m.cursor].hub && len(m.hubCluster().DRPCs) > 0 {
+				m.focus = focusDRPCs
+				m.drpcCursor = 0
+			} else if m.focus == focusDRPCs {
+				if d, ok := m.focusedDRPC(); ok {
+					return m, describeDRPCCmd(m.watchers, m.clusters, d)
+				}
+			}
+
+		case "esc":
+			if m.focus == focusDRPCs {
+				m.focus = focusClusters
+			} else if m.logFocus >= 0 {
+				m.logFocus = -1
+			}
+
+		case "f":
+			if m.focus == focusDRPCs && m.hasManagedClusters() {
+				if d, ok := m.focusedDRPC(); ok {
+					m.confirm = &confirmState{action: "Failover", drpc: d, target: m.failoverTarget()}
+				}
+			}
+
+		case "r":
+			if m.focus == focusDRPCs && m.hasManagedClusters() {
+				if d, ok := m.focusedDRPC(); ok {
+					m.confirm = &confirmState{action: "Relocate", drpc: d, target: m.relocateTarget()}
+				}
+			}
+
+		case "tab":
+			next := m.logFocus + 1
+			if next >= len(m.logPanes) {
+				next = 0
+			}
+			m.logFocus = next
+			return m, tea.Batch(fetchLogsCmd(next, m.clusters[next].context), logsTickCmd(next))
+
+		case "v":
+			if m.logFocus >= 0 {
+				m.logPanes[m.logFocus].toggleView()
+			}
+
+		case "pgup":
+			if m.logFocus >= 0 {
+				vp := &m.logPanes[m.logFocus].viewport
+				vp.LineUp(vp.Height)
+			}
+
+		case "pgdown":
+			if m.logFocus >= 0 {
+				vp := &m.logPanes[m.logFocus].viewport
+				vp.LineDown(vp.Height)
+			}
 		}
 	case time.Time:
 		return m, tea.Batch(updateClustersData(&m.clusters), tickCmd())
@@ -208,13 +570,35 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-func getHubStyle(cluster *clusterInfo, width, height int) string {
+func drpcNames(drpcs []drpcInfo) []string {
+	names := make([]string, len(drpcs))
+	for i, d := range drpcs {
+		names[i] = d.name
+	}
+
+	return names
+}
+
+func getHubStyle(cluster *clusterInfo, width, height int, focus focusLevel, drpcCursor int) string {
 	var msg []string
 
 	msg = append(msg, fmt.Sprintf("%s\n\n", cluster.name))
 	msg = append(msg, fmt.Sprintf("Status: %s\n", cluster.status))
 	msg = append(msg, fmt.Sprintf("Namespaces: %s\n", strings.Join(cluster.namespaces, ",")))
-	msg = append(msg, fmt.Sprintf("DRPCs: %s\n", strings.Join(cluster.DRPCs, ",")))
+
+	if focus == focusDRPCs {
+		msg = append(msg, "DRPCs: (f=failover, r=relocate, enter=describe, esc=back)\n")
+		for i, d := range cluster.DRPCs {
+			cursor := " "
+			if i == drpcCursor {
+				cursor = ">"
+			}
+			msg = append(msg, fmt.Sprintf("%s %s\n", cursor, d.name))
+		}
+	} else {
+		msg = append(msg, fmt.Sprintf("DRPCs: %s\n", strings.Join(drpcNames(cluster.DRPCs), ",")))
+	}
+
 	style := lipgloss.NewStyle().
 		Border(lipgloss.NormalBorder(), true).
 		Width(width).
@@ -240,74 +624,162 @@ func getManagedClusterStyle(cluster *clusterInfo, width, height int) string {
 	return style.Render()
 }
 
+// gridColumns picks how many managed-cluster boxes to pack per row: three
+// once there are enough clusters to make that worthwhile, otherwise two.
+func gridColumns(managedCount int) int {
+	if managedCount > 4 {
+		return 3
+	}
+	return 2
+}
+
+// renderManagedClusterGrid lays the managed (non-hub) clusters out in rows
+// of two or three, so the view adapts to N clusters instead of assuming
+// exactly DR1/DR2.
+func renderManagedClusterGrid(m model) string {
+	managed := m.clusters[1:]
+	cols := gridColumns(len(managed))
+	width := m.width / cols
+
+	var rows []string
+	for start := 0; start < len(managed); start += cols {
+		end := start + cols
+		if end > len(managed) {
+			end = len(managed)
+		}
+
+		var boxes []string
+		for i := start; i < end; i++ {
+			boxes = append(boxes, getManagedClusterStyle(&managed[i], width, m.height/2))
+		}
+
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Left, boxes...))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Top, rows...)
+}
+
+func getLogPaneStyle(pane logPane, title string, focused bool) string {
+	viewName := "events"
+	if pane.view == viewLogs {
+		viewName = "logs"
+	}
+
+	border := lipgloss.NormalBorder()
+	if focused {
+		border = lipgloss.ThickBorder()
+	}
+
+	header := fmt.Sprintf("%s [%s] (tab=focus, v=toggle, pgup/pgdn=scroll)\n", title, viewName)
+	style := lipgloss.NewStyle().
+		Border(border, true).
+		Width(pane.viewport.Width).
+		Height(pane.viewport.Height + 1).
+		Align(lipgloss.Left)
+
+	return style.Render(header + pane.viewport.View())
+}
+
+func getConfirmModalStyle(c confirmState, width int) string {
+	msg := fmt.Sprintf("%s %s to %s? (y/n)", c.action, c.drpc.name, c.target)
+
+	style := lipgloss.NewStyle().
+		Border(lipgloss.ThickBorder(), true).
+		Width(width / 2).
+		Align(lipgloss.Center).
+		SetString(msg)
+
+	return style.Render()
+}
+
 func (m model) View() string {
-	var hubStyle string
-	var dr1Style string
-	var dr2Style string
+	if m.describeView != nil {
+		w, h := describeViewSize(m)
+		return getDescribeViewStyle(*m.describeView, w, h)
+	}
+
+	hubStyle := getHubStyle(&m.clusters[0], m.width, m.height/3, m.focus, m.drpcCursor)
+	managedGrid := renderManagedClusterGrid(m)
 
-	hubStyle = getHubStyle(&m.clusters[0], m.width, m.height/3)
-	dr1Style = getManagedClusterStyle(&m.clusters[1], m.width/2, m.height/2)
-	dr2Style = getManagedClusterStyle(&m.clusters[2], m.width/2, m.height/2)
+	var logPaneStyles []string
+	for i, cluster := range m.clusters {
+		logPaneStyles = append(logPaneStyles, getLogPaneStyle(m.logPanes[i], cluster.name, m.logFocus == i))
+	}
 
 	// Render the final view with the new layout
 	s := lipgloss.JoinVertical(
 		lipgloss.Top,
 		hubStyle,
-		lipgloss.JoinHorizontal(
-			lipgloss.Left,
-			dr1Style,
-			dr2Style))
-	s += "\n\nPress q to quit.\n"
-
-	return s
-}
+		managedGrid,
+		lipgloss.JoinHorizontal(lipgloss.Left, logPaneStyles...))
 
-func addSchemes() {
-	err := ocmworkv1.AddToScheme(scheme.Scheme)
-	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+	if m.confirm != nil {
+		s += "\n" + getConfirmModalStyle(*m.confirm, m.width)
 	}
 
-	err = ramen.AddToScheme(scheme.Scheme)
-	if err != nil {
-		fmt.Println(err)
-		os.Exit(1)
+	if m.statusMsg != "" {
+		s += fmt.Sprintf("\n%s\n", m.statusMsg)
 	}
 
-	// err = ocmclv1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
-
-	// err = plrv1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+	if line := renderUnavailableProviders(m.availability); line != "" {
+		s += fmt.Sprintf("\n%s\n", line)
+	}
 
-	// err = viewv1beta1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+	s += "\nPress q to quit.\n"
 
-	// err = cpcv1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+	return s
+}
 
-	// err = gppv1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+// renderUnavailableProviders reports which optional schemes.Provider CRDs
+// are missing on the hub, as a status line. Returns "" once every provider
+// is available.
+func renderUnavailableProviders(availability map[string]bool) string {
+	var missing []string
+	for name, ok := range availability {
+		if !ok {
+			missing = append(missing, name)
+		}
+	}
 
-	// err = Recipe.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+	if len(missing) == 0 {
+		return ""
+	}
 
-	// err = volrep.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+	sort.Strings(missing)
 
-	// err = volsyncv1alpha1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+	return fmt.Sprintf("Unavailable CRDs (not installed on hub): %s", strings.Join(missing, ", "))
+}
 
-	// err = snapv1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
-	// Expect(velero.AddToScheme(scheme.Scheme)).To(Succeed())
+// registerSchemes registers the core and optional API groups ramenbooth
+// knows about. Failing to register a core scheme is fatal, since every
+// hub/DRPC/VRG client call in the app depends on it; an optional provider
+// whose types fail to register with the local scheme is only printed --
+// it shouldn't keep the rest of the tool from starting. Whether a
+// provider's CRDs are installed is probed separately by
+// schemes.Availability.
+func registerSchemes() {
+	providerErrs, err := schemes.Register(scheme.Scheme, schemes.Options{})
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
 
-	// err = clrapiv1beta1.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+	for _, err := range providerErrs {
+		fmt.Println(err)
+	}
+}
 
-	// err = argocdv1alpha1hack.AddToScheme(scheme.Scheme)
-	// Expect(err).NotTo(HaveOccurred())
+// startWatchers launches a background informer-based watcher per cluster,
+// so the TUI learns about DRPC/ManifestWork/VRG/Namespace/Node changes as
+// they happen instead of waiting for the next resync tick.
+func startWatchers(ctx context.Context, p *tea.Program, clusters []clusterInfo, registry *watcher.Registry) {
+	for _, c := range clusters {
+		go func(name, kubeconfig string, isHub bool) {
+			if err := watcher.Start(ctx, p, name, kubeconfig, scheme.Scheme, isHub, registry); err != nil {
+				p.Send(watcher.ErrorMsg{ClusterName: name, Err: err})
+			}
+		}(c.name, c.context, c.hub)
+	}
 }
 
 func main() {
@@ -317,8 +789,30 @@ func main() {
 		TimeEncoder: zapcore.ISO8601TimeEncoder,
 	}))
 	logf.SetLogger(testLogger)
-	addSchemes()
-	p := tea.NewProgram(initialModel(), tea.WithAltScreen())
+	registerSchemes()
+
+	hubClient := fetchClusterClient(hub)
+	if hubClient == nil {
+		fmt.Println("failed to build a client for -hub")
+		os.Exit(1)
+	}
+
+	managed, err := clusterdiscovery.Discover(context.Background(), hubClient, kubeconfigDir)
+	if err != nil {
+		fmt.Printf("failed to discover managed clusters: %v\n", err)
+		os.Exit(1)
+	}
+
+	m := initialModel(managed)
+	m.availability = schemes.Availability(schemes.Options{DiscoveryClient: fetchDiscoveryClient(hub)})
+	m.watchers = watcher.NewRegistry()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	p := tea.NewProgram(m, tea.WithAltScreen())
+	startWatchers(ctx, p, m.clusters, m.watchers)
+
 	if _, err := p.Run(); err != nil {
 		fmt.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
@@ -0,0 +1,55 @@
+package main
+
+import "testing"
+
+func TestGridColumns(t *testing.T) {
+	cases := []struct {
+		managedCount int
+		want         int
+	}{
+		{managedCount: 1, want: 2},
+		{managedCount: 4, want: 2},
+		{managedCount: 5, want: 3},
+	}
+
+	for _, c := range cases {
+		if got := gridColumns(c.managedCount); got != c.want {
+			t.Errorf("gridColumns(%d) = %d, want %d", c.managedCount, got, c.want)
+		}
+	}
+}
+
+func TestFailoverTarget(t *testing.T) {
+	m := model{clusters: []clusterInfo{
+		newClusterInfo("hub", "hub-ctx", true, false),
+		newClusterInfo("east", "east-ctx", false, true),
+		newClusterInfo("west", "west-ctx", false, true),
+	}}
+
+	if got := m.failoverTarget(); got != "west" {
+		t.Errorf("failoverTarget() = %q, want %q", got, "west")
+	}
+}
+
+func TestRelocateTarget(t *testing.T) {
+	m := model{clusters: []clusterInfo{
+		newClusterInfo("hub", "hub-ctx", true, false),
+		newClusterInfo("east", "east-ctx", false, true),
+		newClusterInfo("west", "west-ctx", false, true),
+	}}
+
+	if got := m.relocateTarget(); got != "east" {
+		t.Errorf("relocateTarget() = %q, want %q", got, "east")
+	}
+}
+
+func TestFailoverAndRelocateTarget_NoManagedClusters(t *testing.T) {
+	m := model{clusters: []clusterInfo{newClusterInfo("hub", "hub-ctx", true, false)}}
+
+	if got := m.failoverTarget(); got != "" {
+		t.Errorf("failoverTarget() = %q, want empty string with no managed clusters", got)
+	}
+	if got := m.relocateTarget(); got != "" {
+		t.Errorf("relocateTarget() = %q, want empty string with no managed clusters", got)
+	}
+}
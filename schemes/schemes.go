@@ -0,0 +1,125 @@
+// Package schemes registers the Kubernetes API groups ramenbooth knows
+// about, treating everything beyond the core ManifestWork/ramen types as an
+// optional Provider: a cluster missing a provider's CRDs (VolSync isn't
+// installed, say) should not keep the rest of the tool from starting.
+package schemes
+
+import (
+	"fmt"
+
+	volsyncv1alpha1 "github.com/backube/volsync/api/v1alpha1"
+	volrep "github.com/csi-addons/kubernetes-csi-addons/apis/replication.storage/v1alpha1"
+	ocmworkv1 "github.com/open-cluster-management/api/work/v1"
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	recipe "github.com/ramendr/recipe/api/v1alpha1"
+	viewv1beta1 "github.com/stolostron/multicloud-operators-foundation/pkg/apis/view/v1beta1"
+	velero "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+// Provider registers one resource kind's types with a scheme and reports
+// the GroupVersion the UI can probe for to decide whether that kind's data
+// is actually available on a cluster. Required providers (VRG,
+// ManifestWork) are the kinds ramenbooth can't function without: a failure
+// registering one of those is fatal. All other providers are optional: a
+// cluster missing a provider's CRDs (VolSync isn't installed, say)
+// shouldn't keep the rest of the tool from starting. New resource kinds
+// implement this and get added to Providers, without touching main.go.
+type Provider interface {
+	Name() string
+	GroupVersion() schema.GroupVersion
+	AddToScheme(*runtime.Scheme) error
+	Required() bool
+}
+
+type funcProvider struct {
+	name     string
+	gv       schema.GroupVersion
+	add      func(*runtime.Scheme) error
+	required bool
+}
+
+func (p funcProvider) Name() string { return p.name }
+
+func (p funcProvider) GroupVersion() schema.GroupVersion { return p.gv }
+
+func (p funcProvider) AddToScheme(s *runtime.Scheme) error { return p.add(s) }
+
+func (p funcProvider) Required() bool { return p.required }
+
+// Providers lists every resource kind ramenbooth knows about. VRG and
+// ManifestWork are required: nothing in the tool works without them. The
+// rest are optional, displayed only if their CRDs happen to be installed:
+// ManagedClusterView, Recipe, VolumeReplication, VolSync
+// (ReplicationSource and ReplicationDestination share a group/version),
+// and Velero (Backup/Restore share a group/version too).
+func Providers() []Provider {
+	return []Provider{
+		funcProvider{name: "VolumeReplicationGroup", gv: ramen.GroupVersion, add: ramen.AddToScheme, required: true},
+		funcProvider{name: "ManifestWork", gv: ocmworkv1.GroupVersion, add: ocmworkv1.AddToScheme, required: true},
+		funcProvider{name: "ManagedClusterView", gv: viewv1beta1.GroupVersion, add: viewv1beta1.AddToScheme},
+		funcProvider{name: "Recipe", gv: recipe.GroupVersion, add: recipe.AddToScheme},
+		funcProvider{name: "VolumeReplication", gv: volrep.GroupVersion, add: volrep.AddToScheme},
+		funcProvider{name: "VolSync", gv: volsyncv1alpha1.GroupVersion, add: volsyncv1alpha1.AddToScheme},
+		funcProvider{name: "Velero", gv: velero.SchemeGroupVersion, add: velero.AddToScheme},
+	}
+}
+
+// Options configures CRD-availability probing.
+type Options struct {
+	// DiscoveryClient is used to probe whether each optional provider's
+	// CRDs are actually installed on a cluster. Nil skips probing:
+	// Availability then reports nothing, and callers should assume every
+	// panel is available.
+	DiscoveryClient discovery.DiscoveryInterface
+}
+
+// Register attempts every Provider, required and optional alike, through
+// the same Provider interface. A required provider failing to register is
+// fatal -- returned immediately, registration of the rest abandoned --
+// since nothing in the tool works without VRG or ManifestWork. Optional
+// providers are independent of each other and of the required ones, so
+// one failing to register doesn't stop the rest: their failures are
+// collected and returned alongside a nil fatal error.
+func Register(scheme *runtime.Scheme, opts Options) ([]error, error) {
+	var providerErrs []error
+
+	for _, p := range Providers() {
+		err := p.AddToScheme(scheme)
+		if err == nil {
+			continue
+		}
+
+		if p.Required() {
+			return nil, fmt.Errorf("%s: %w", p.Name(), err)
+		}
+
+		providerErrs = append(providerErrs, fmt.Errorf("%s: %w", p.Name(), err))
+	}
+
+	return providerErrs, nil
+}
+
+// Availability probes opts.DiscoveryClient for each optional provider's
+// GroupVersion and reports which ones are actually installed on the
+// cluster. Required providers are skipped: a missing one is already fatal
+// in Register, so there's nothing useful to report about it here.
+func Availability(opts Options) map[string]bool {
+	available := map[string]bool{}
+	if opts.DiscoveryClient == nil {
+		return available
+	}
+
+	for _, p := range Providers() {
+		if p.Required() {
+			continue
+		}
+
+		_, err := opts.DiscoveryClient.ServerResourcesForGroupVersion(p.GroupVersion().String())
+		available[p.Name()] = err == nil
+	}
+
+	return available
+}
@@ -0,0 +1,154 @@
+// Package watcher builds a controller-runtime cache per kubeconfig and
+// streams add/update/delete events for the resource kinds the TUI displays
+// into a bubbletea program, replacing the previous full-relist-per-tick
+// approach.
+package watcher
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	ocmworkv1 "github.com/open-cluster-management/api/work/v1"
+	ramen "github.com/ramendr/ramen/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ResyncPeriod bounds how long an informer can go without a full re-list,
+// as a safety net against silently dropped watch events.
+const ResyncPeriod = 5 * time.Minute
+
+// ChangeMsg reports that a watched resource changed on a cluster, so the
+// TUI can refresh that cluster's data instead of waiting on a timer.
+type ChangeMsg struct {
+	ClusterName string
+	Kind        string
+}
+
+// ErrorMsg reports a problem starting or running one cluster's watcher.
+// It's sent through the bubbletea program rather than printed directly,
+// since the program runs in alt-screen mode and stray stdout writes from
+// a background goroutine would corrupt the rendered TUI.
+type ErrorMsg struct {
+	ClusterName string
+	Err         error
+}
+
+// Registry hands out the already-synced cache.Cache Start keeps running
+// for each cluster, so other subsystems (the describe view) can read from
+// the same informer cache instead of opening a new direct connection per
+// call.
+type Registry struct {
+	mu     sync.RWMutex
+	caches map[string]cache.Cache
+}
+
+// NewRegistry returns an empty Registry, populated as each cluster's
+// Start call finishes its initial sync.
+func NewRegistry() *Registry {
+	return &Registry{caches: map[string]cache.Cache{}}
+}
+
+// Get returns the synced cache for clusterName, and false if that
+// cluster's watcher hasn't started or hasn't finished its initial sync
+// yet.
+func (r *Registry) Get(clusterName string) (client.Reader, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	c, ok := r.caches[clusterName]
+
+	return c, ok
+}
+
+func (r *Registry) set(clusterName string, c cache.Cache) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.caches[clusterName] = c
+}
+
+// watchedKinds are the object kinds the TUI needs informers for, which
+// differ by cluster role: DRPlacementControl and ManifestWork only exist
+// on the hub, VolumeReplicationGroup only on managed clusters, and
+// Namespace/Node are common to both.
+func watchedKinds(isHub bool) []client.Object {
+	kinds := []client.Object{
+		&corev1.Namespace{},
+		&corev1.Node{},
+	}
+
+	if isHub {
+		return append(kinds, &ramen.DRPlacementControl{}, &ocmworkv1.ManifestWork{})
+	}
+
+	return append(kinds, &ramen.VolumeReplicationGroup{})
+}
+
+// Start builds a cache for the cluster at kubeconfig, registers informers
+// for watchedKinds(isHub), and runs the cache until ctx is done, sending a
+// ChangeMsg to p for every add/update/delete it observes. A kind whose
+// informer fails to register (e.g. its CRD isn't installed) is reported
+// via an ErrorMsg rather than aborting the other kinds' informers. Start
+// blocks until the initial cache sync completes, then registers the cache
+// in registry under clusterName so other subsystems can read from it.
+func Start(ctx context.Context, p *tea.Program, clusterName, kubeconfig string, scheme *runtime.Scheme, isHub bool, registry *Registry) error {
+	config, err := clientcmd.BuildConfigFromFlags("", kubeconfig)
+	if err != nil {
+		return fmt.Errorf("failed to build config for cluster %s: %w", clusterName, err)
+	}
+
+	resync := ResyncPeriod
+	c, err := cache.New(config, cache.Options{Scheme: scheme, SyncPeriod: &resync})
+	if err != nil {
+		return fmt.Errorf("failed to create cache for cluster %s: %w", clusterName, err)
+	}
+
+	for _, obj := range watchedKinds(isHub) {
+		if err := registerHandler(ctx, c, obj, p, clusterName); err != nil {
+			p.Send(ErrorMsg{ClusterName: clusterName, Err: err})
+		}
+	}
+
+	go func() {
+		_ = c.Start(ctx)
+	}()
+
+	if !c.WaitForCacheSync(ctx) {
+		return fmt.Errorf("cache never synced for cluster %s", clusterName)
+	}
+
+	registry.set(clusterName, c)
+
+	return nil
+}
+
+// registerHandler wires a ChangeMsg send into every add/update/delete the
+// informer for obj observes.
+func registerHandler(ctx context.Context, c cache.Cache, obj client.Object, p *tea.Program, clusterName string) error {
+	informer, err := c.GetInformer(ctx, obj)
+	if err != nil {
+		return fmt.Errorf("failed to get informer for %T on cluster %s: %w", obj, clusterName, err)
+	}
+
+	kind := fmt.Sprintf("%T", obj)
+	send := func() { p.Send(ChangeMsg{ClusterName: clusterName, Kind: kind}) }
+
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { send() },
+		UpdateFunc: func(interface{}, interface{}) { send() },
+		DeleteFunc: func(interface{}) { send() },
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add event handler for %T on cluster %s: %w", obj, clusterName, err)
+	}
+
+	return nil
+}